@@ -0,0 +1,38 @@
+// Copyright (c) 2025 ADBC Drivers Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+// Well-known driverbase.ErrorDetail keys populated by MySQLErrorInspector.
+// Each SHOW WARNINGS row contributes one DetailKeyWarningLevel,
+// DetailKeyWarningCode, and DetailKeyWarningMessage entry, in that order,
+// so clients can group them back into rows without re-querying the server.
+const (
+	// DetailKeyWarningLevel is a single SHOW WARNINGS row's Level column
+	// (e.g. "Warning", "Note", "Error").
+	DetailKeyWarningLevel = "mysql.warning.level"
+	// DetailKeyWarningCode is a single SHOW WARNINGS row's Code column,
+	// formatted as decimal text.
+	DetailKeyWarningCode = "mysql.warning.code"
+	// DetailKeyWarningMessage is a single SHOW WARNINGS row's Message column.
+	DetailKeyWarningMessage = "mysql.warning.message"
+	// DetailKeyServerVersion is the server's VERSION() string.
+	DetailKeyServerVersion = "mysql.server_version"
+	// DetailKeyThreadID is the server's CONNECTION_ID(), formatted as
+	// decimal text.
+	DetailKeyThreadID = "mysql.thread_id"
+	// DetailKeySQLStateClass is the two-character class (e.g. "22" for data
+	// exceptions) of ErrorInfo.SqlState, whichever way Status was chosen.
+	DetailKeySQLStateClass = "mysql.sqlstate.class"
+)
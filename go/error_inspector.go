@@ -12,71 +12,95 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:generate go run ./internal/gen/mysqlerrors -input internal/gen/mysqlerrors/errors.tsv -output mysql_error_table.go
+
 package mysql
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
 	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
 
 	"github.com/adbc-drivers/driverbase-go/driverbase"
 	"github.com/apache/arrow-adbc/go/adbc"
 	"github.com/go-sql-driver/mysql"
 )
 
+// effectiveSQLState returns mysqlErr's native SQLSTATE, falling back to
+// mysqlErrorTable's entry for its code when the error carries a zero
+// SQLSTATE. Both InspectError and IsRetryable use this so the
+// rule registry's SQLSTATE-prefix rules (RegisterSQLStateRule) see the same
+// backfilled value that ends up in ErrorInfo.SqlState, instead of only ever
+// matching against whatever the driver reported natively.
+func effectiveSQLState(mysqlErr *mysql.MySQLError) string {
+	if mysqlErr.SQLState != [5]byte{} {
+		return string(mysqlErr.SQLState[:])
+	}
+	if entry, ok := mysqlErrorTable[int(mysqlErr.Number)]; ok {
+		return entry.SQLState
+	}
+	return ""
+}
+
+// isConnectionLost reports whether err is one of the sentinel/network errors
+// go-sql-driver/mysql actually returns for a dropped or refused connection.
+// The driver never wraps these in a *mysql.MySQLError (that type is only
+// constructed from a server ERR packet), so errors.As against it always
+// fails for this failure mode; callers need to check for these directly.
+func isConnectionLost(err error) bool {
+	return errors.Is(err, driver.ErrBadConn) || errors.Is(err, mysql.ErrInvalidConn) || errors.Is(err, io.EOF)
+}
+
+// ErrorInspector inspects MySQL errors and classifies them for ADBC,
+// optionally consulting server warnings and the retry-classification
+// registry (RegisterErrorRule, RegisterSQLStateRule).
+type ErrorInspector interface {
+	InspectError(err error, defaultStatus adbc.Status) driverbase.ErrorInfo
+	InspectWarnings(ctx context.Context, conn *sql.Conn) ([]driverbase.ErrorDetail, error)
+	IsRetryable(err error) (bool, time.Duration)
+}
+
 type MySQLErrorInspector struct{}
 
+var _ ErrorInspector = MySQLErrorInspector{}
+
 // InspectError examines a MySQL error and extracts metadata
 // mysql error codes: https://www.fromdual.com/mysql-error-codes-and-messages
 func (m MySQLErrorInspector) InspectError(err error, defaultStatus adbc.Status) driverbase.ErrorInfo {
 	info := driverbase.ErrorInfo{Status: defaultStatus}
 
 	var mysqlErr *mysql.MySQLError
-	if errors.As(err, &mysqlErr) {
+	switch {
+	case errors.As(err, &mysqlErr):
 		info.VendorCode = int32(mysqlErr.Number)
-		info.SqlState = string(mysqlErr.SQLState[:])
-
-		switch mysqlErr.Number {
-		case 1045: // ER_ACCESS_DENIED_ERROR
-			info.Status = adbc.StatusUnauthenticated
-		case 1044, 1142, 1143, 1227: // Permission errors
-			info.Status = adbc.StatusUnauthorized
-		case 1146: // ER_NO_SUCH_TABLE
-			info.Status = adbc.StatusNotFound
-		case 1049: // ER_BAD_DB_ERROR
-			info.Status = adbc.StatusNotFound
-		case 1050: // ER_TABLE_EXISTS_ERROR
-			info.Status = adbc.StatusAlreadyExists
-		case 1007: // ER_DB_CREATE_EXISTS
-			info.Status = adbc.StatusAlreadyExists
-		case 1062: // ER_DUP_ENTRY
-			info.Status = adbc.StatusIntegrity
-		case 1451: // ER_ROW_IS_REFERENCED_2 (foreign key constraint)
-			info.Status = adbc.StatusIntegrity
-		case 1452: // ER_NO_REFERENCED_ROW_2 (foreign key constraint)
-			info.Status = adbc.StatusIntegrity
-		case 1048: // ER_BAD_NULL_ERROR
-			info.Status = adbc.StatusIntegrity
-		case 1364: // ER_NO_DEFAULT_FOR_FIELD
-			info.Status = adbc.StatusIntegrity
-		case 1064: // ER_PARSE_ERROR
-			info.Status = adbc.StatusInvalidArgument
-		case 1054: // ER_BAD_FIELD_ERROR
-			info.Status = adbc.StatusInvalidArgument
-		case 1052: // ER_NON_UNIQ_ERROR
-			info.Status = adbc.StatusInvalidArgument
-		case 1366: // ER_TRUNCATED_WRONG_VALUE_FOR_FIELD
-			info.Status = adbc.StatusInvalidData
-		case 1292: // ER_TRUNCATED_WRONG_VALUE
-			info.Status = adbc.StatusInvalidData
-		case 1264: // ER_WARN_DATA_OUT_OF_RANGE
-			info.Status = adbc.StatusInvalidData
-		case 1205: // ER_LOCK_WAIT_TIMEOUT
-			info.Status = adbc.StatusTimeout
-		case 1213: // ER_LOCK_DEADLOCK
-			info.Status = adbc.StatusCancelled
-		case 2002, 2003, 2006, 2013: // Various connection errors
-			info.Status = adbc.StatusIO
-		case 1105: // ER_UNKNOWN_ERROR
-			info.Status = adbc.StatusInternal
+		// mysqlErr.SQLState is a fixed [5]byte; the server leaves it all
+		// zero when it didn't report one, which renders as 5 NUL bytes, not
+		// "". effectiveSQLState backfills from mysqlErrorTable in that case,
+		// for any code the table lists (including the CR_* client codes).
+		info.SqlState = effectiveSQLState(mysqlErr)
+
+		// mysqlErrorTable (generated from internal/gen/mysqlerrors/errors.tsv)
+		// maps a commonly-encountered subset of MySQL codes to SQLSTATE and
+		// Status; codes it does not list are left to the rules and the
+		// SQLSTATE-prefix fallback below.
+		if entry, ok := mysqlErrorTable[int(mysqlErr.Number)]; ok {
+			info.Status = entry.Status
+		}
+
+		// A registered Rule (RegisterErrorRule/RegisterSQLStateRule) takes
+		// priority over the built-in mysqlErrorTable, so embedders can
+		// override or extend the classification for their deployment.
+		// Pass the already-backfilled info.SqlState, not mysqlErr.SQLState
+		// directly, so a SQLSTATE-prefix rule still matches errors whose
+		// native SQLSTATE was empty.
+		if rule, ok := defaultRegistry.lookup(mysqlErr, info.SqlState); ok {
+			status, _, _ := rule(mysqlErr)
+			info.Status = status
 		}
 
 		// If status still not determined, use SQLSTATE prefix as fallback.
@@ -92,10 +116,14 @@ func (m MySQLErrorInspector) InspectError(err error, defaultStatus adbc.Status)
 				info.Status = adbc.StatusInvalidData
 			case "23": // Integrity constraint violation
 				info.Status = adbc.StatusIntegrity
+			case "25": // Invalid transaction state
+				info.Status = adbc.StatusInvalidState
 			case "28": // Invalid authorization
 				info.Status = adbc.StatusUnauthenticated
 			case "34": // Invalid cursor name
 				info.Status = adbc.StatusInvalidArgument
+			case "40": // Transaction rollback (deadlock/serialization failure)
+				info.Status = adbc.StatusCancelled
 			case "42": // Syntax error or access rule violation
 				info.Status = adbc.StatusInvalidArgument
 			case "44": // WITH CHECK OPTION violation
@@ -104,9 +132,133 @@ func (m MySQLErrorInspector) InspectError(err error, defaultStatus adbc.Status)
 				info.Status = adbc.StatusInvalidState
 			case "58": // System error
 				info.Status = adbc.StatusInternal
+				// Deliberately no "HY" case here: HY000 is MySQL's generic/
+				// unspecified-error class, covering dozens of unrelated errors
+				// (ER_UNKNOWN_ERROR, out-of-memory, ER_CON_COUNT_ERROR, ...),
+				// not a timeout class. The only HY-class timeouts (1205, 1907)
+				// are already classified by code via mysqlErrorTable/the
+				// registry before this fallback runs; treating the whole
+				// class as StatusTimeout here would mislabel every other
+				// HY000 error.
 			}
 		}
+
+		if len(info.SqlState) >= 2 {
+			info.Details = append(info.Details, driverbase.ErrorDetail{
+				Key:   DetailKeySQLStateClass,
+				Value: []byte(info.SqlState[:2]),
+			})
+		}
+
+	case isConnectionLost(err):
+		// go-sql-driver/mysql surfaces a lost or refused connection as one
+		// of these sentinels rather than a *mysql.MySQLError, so there's no
+		// code or SQLSTATE to classify on.
+		info.Status = adbc.StatusIO
 	}
 
 	return info
 }
+
+// InspectWarnings queries SHOW WARNINGS on conn and packs each row into
+// details under DetailKeyWarningLevel/Code/Message, along with
+// DetailKeyServerVersion and DetailKeyThreadID. It is meant to be called
+// immediately after a statement (failed or not) on the same connection, since
+// SHOW WARNINGS only reflects the most recently executed statement. It
+// returns a nil slice and no error when there are no warnings to report.
+func (m MySQLErrorInspector) InspectWarnings(ctx context.Context, conn *sql.Conn) ([]driverbase.ErrorDetail, error) {
+	var count int
+	if err := conn.QueryRowContext(ctx, "SHOW COUNT(*) WARNINGS").Scan(&count); err != nil {
+		return nil, fmt.Errorf("mysql: querying warning count: %w", err)
+	}
+	if count == 0 {
+		return nil, nil
+	}
+
+	rows, err := conn.QueryContext(ctx, "SHOW WARNINGS")
+	if err != nil {
+		return nil, fmt.Errorf("mysql: querying warnings: %w", err)
+	}
+	defer rows.Close()
+
+	var details []driverbase.ErrorDetail
+	for rows.Next() {
+		var level, message string
+		var code int
+		if err := rows.Scan(&level, &code, &message); err != nil {
+			return nil, fmt.Errorf("mysql: scanning warning row: %w", err)
+		}
+		details = append(details,
+			driverbase.ErrorDetail{Key: DetailKeyWarningLevel, Value: []byte(level)},
+			driverbase.ErrorDetail{Key: DetailKeyWarningCode, Value: []byte(strconv.Itoa(code))},
+			driverbase.ErrorDetail{Key: DetailKeyWarningMessage, Value: []byte(message)},
+		)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("mysql: iterating warnings: %w", err)
+	}
+
+	var version string
+	var threadID int64
+	if err := conn.QueryRowContext(ctx, "SELECT VERSION(), CONNECTION_ID()").Scan(&version, &threadID); err == nil {
+		details = append(details,
+			driverbase.ErrorDetail{Key: DetailKeyServerVersion, Value: []byte(version)},
+			driverbase.ErrorDetail{Key: DetailKeyThreadID, Value: []byte(strconv.FormatInt(threadID, 10))},
+		)
+	}
+
+	return details, nil
+}
+
+// IsRetryable reports whether err is classified as safe to retry and, if so,
+// how long to back off before retrying. A dropped/refused connection
+// (isConnectionLost) is always retryable with no backoff, since reconnect is
+// what makes it safe to retry, not waiting. Otherwise it consults the rule
+// registry (RegisterErrorRule/RegisterSQLStateRule); errors with no matching
+// rule are treated as not retryable.
+func (m MySQLErrorInspector) IsRetryable(err error) (bool, time.Duration) {
+	if isConnectionLost(err) {
+		return true, 0
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) {
+		return false, 0
+	}
+
+	rule, ok := defaultRegistry.lookup(mysqlErr, effectiveSQLState(mysqlErr))
+	if !ok {
+		return false, 0
+	}
+	_, retryable, backoff := rule(mysqlErr)
+	return retryable, backoff
+}
+
+// Classification is the full retry classification for a MySQL error: the
+// adbc.Status it maps to, whether retrying is safe, how long to back off
+// first, and whether the connection needs to be re-opened before retrying.
+type Classification struct {
+	Status    adbc.Status
+	Retryable bool
+	Backoff   time.Duration
+	Reconnect bool
+}
+
+// Classify inspects err and returns its Classification. Reconnect is set
+// when the error classifies as adbc.StatusIO (server-gone/lost-connection
+// errors like 2006/2013/2002, and the isConnectionLost sentinels go-sql-
+// driver/mysql actually returns for those) and is retryable, since those
+// require re-opening the underlying connection rather than just re-running
+// the statement; deadlocks (StatusCancelled) are retried in place after
+// Backoff. Classify delegates entirely to InspectError and IsRetryable, so
+// it handles whatever error shapes they do, not just *mysql.MySQLError.
+func (m MySQLErrorInspector) Classify(err error) Classification {
+	status := m.InspectError(err, adbc.StatusUnknown).Status
+	retryable, backoff := m.IsRetryable(err)
+	return Classification{
+		Status:    status,
+		Retryable: retryable,
+		Backoff:   backoff,
+		Reconnect: retryable && status == adbc.StatusIO,
+	}
+}
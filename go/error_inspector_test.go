@@ -0,0 +1,210 @@
+// Copyright (c) 2025 ADBC Drivers Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow-adbc/go/adbc"
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestInspectError_TableHit(t *testing.T) {
+	inspector := MySQLErrorInspector{}
+	err := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"} // ER_DUP_ENTRY, zero SQLSTATE
+
+	info := inspector.InspectError(err, adbc.StatusUnknown)
+
+	if info.Status != adbc.StatusIntegrity {
+		t.Errorf("Status = %v, want %v", info.Status, adbc.StatusIntegrity)
+	}
+	if info.SqlState != "23000" {
+		t.Errorf("SqlState = %q, want %q", info.SqlState, "23000")
+	}
+	if info.VendorCode != 1062 {
+		t.Errorf("VendorCode = %d, want 1062", info.VendorCode)
+	}
+}
+
+func TestInspectError_SQLStateBackfillForConnectionDrop(t *testing.T) {
+	inspector := MySQLErrorInspector{}
+	// A CR_SERVER_GONE_ERROR carrying a zero SQLSTATE; mysqlErrorTable
+	// should backfill it to "HY000".
+	err := &mysql.MySQLError{Number: 2006, Message: "MySQL server has gone away"}
+
+	info := inspector.InspectError(err, adbc.StatusUnknown)
+
+	if info.SqlState != "HY000" {
+		t.Errorf("SqlState = %q, want %q", info.SqlState, "HY000")
+	}
+	if info.Status != adbc.StatusIO {
+		t.Errorf("Status = %v, want %v", info.Status, adbc.StatusIO)
+	}
+}
+
+func TestInspectError_RegistryOverridesTable(t *testing.T) {
+	inspector := MySQLErrorInspector{}
+	// 1100 (ER_TABLE_NOT_LOCKED) is StatusInvalidState in mysqlErrorTable and
+	// is not used by any other test here.
+	RegisterErrorRule(1100, func(*mysql.MySQLError) (adbc.Status, bool, time.Duration) {
+		return adbc.StatusUnauthorized, false, 0
+	})
+
+	info := inspector.InspectError(&mysql.MySQLError{Number: 1100, Message: "synthetic"}, adbc.StatusUnknown)
+
+	if info.Status != adbc.StatusUnauthorized {
+		t.Errorf("Status = %v, want %v", info.Status, adbc.StatusUnauthorized)
+	}
+}
+
+func TestInspectError_SQLStateRuleMatchesBackfilledState(t *testing.T) {
+	inspector := MySQLErrorInspector{}
+	// 2014 (CR_COMMANDS_OUT_OF_SYNC) has no byCode rule registered, so this
+	// isolates the bySQLState path. It reports a zero native SQLSTATE;
+	// mysqlErrorTable backfills it to "HY000". If lookup matched
+	// mysqlErr.SQLState directly instead of that backfilled value, the
+	// for-loop in errorRegistry.lookup would iterate an empty string and
+	// this rule would never fire.
+	RegisterSQLStateRule("HY", func(*mysql.MySQLError) (adbc.Status, bool, time.Duration) {
+		return adbc.StatusUnauthenticated, true, 0
+	})
+
+	info := inspector.InspectError(&mysql.MySQLError{Number: 2014, Message: "Commands out of sync"}, adbc.StatusUnknown)
+
+	if info.Status != adbc.StatusUnauthenticated {
+		t.Errorf("Status = %v, want %v (SQLSTATE-prefix rule should win over the table)", info.Status, adbc.StatusUnauthenticated)
+	}
+}
+
+func TestInspectError_SQLStatePrefixFallback(t *testing.T) {
+	inspector := MySQLErrorInspector{}
+	// Number is not in mysqlErrorTable and has no registered rule, so the
+	// only way to classify it is the native SQLSTATE's class prefix.
+	err := &mysql.MySQLError{Number: 65002, SQLState: [5]byte{'2', '8', '0', '0', '0'}, Message: "synthetic"}
+
+	info := inspector.InspectError(err, adbc.StatusUnknown)
+
+	if info.Status != adbc.StatusUnauthenticated {
+		t.Errorf("Status = %v, want %v", info.Status, adbc.StatusUnauthenticated)
+	}
+}
+
+func TestInspectError_ConnectionLostSentinels(t *testing.T) {
+	inspector := MySQLErrorInspector{}
+	for _, err := range []error{driver.ErrBadConn, mysql.ErrInvalidConn, io.EOF} {
+		info := inspector.InspectError(err, adbc.StatusUnknown)
+		if info.Status != adbc.StatusIO {
+			t.Errorf("InspectError(%v).Status = %v, want %v", err, info.Status, adbc.StatusIO)
+		}
+	}
+}
+
+func TestIsRetryable_ConnectionLost(t *testing.T) {
+	inspector := MySQLErrorInspector{}
+	retryable, backoff := inspector.IsRetryable(driver.ErrBadConn)
+	if !retryable || backoff != 0 {
+		t.Errorf("IsRetryable(driver.ErrBadConn) = (%v, %v), want (true, 0)", retryable, backoff)
+	}
+}
+
+func TestIsRetryable_NoRuleIsNotRetryable(t *testing.T) {
+	inspector := MySQLErrorInspector{}
+	retryable, _ := inspector.IsRetryable(&mysql.MySQLError{Number: 1064, Message: "syntax error"})
+	if retryable {
+		t.Errorf("IsRetryable(1064) = true, want false")
+	}
+}
+
+func TestInspectWarnings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() = %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery("SHOW COUNT\\(\\*\\) WARNINGS").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("SHOW WARNINGS").
+		WillReturnRows(sqlmock.NewRows([]string{"Level", "Code", "Message"}).
+			AddRow("Warning", 1264, "Out of range value"))
+	mock.ExpectQuery("SELECT VERSION\\(\\), CONNECTION_ID\\(\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "id"}).AddRow("8.0.36", 42))
+
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("db.Conn() = %v", err)
+	}
+	defer conn.Close()
+
+	inspector := MySQLErrorInspector{}
+	details, err := inspector.InspectWarnings(context.Background(), conn)
+	if err != nil {
+		t.Fatalf("InspectWarnings() = %v", err)
+	}
+
+	want := []struct {
+		key   string
+		value string
+	}{
+		{DetailKeyWarningLevel, "Warning"},
+		{DetailKeyWarningCode, "1264"},
+		{DetailKeyWarningMessage, "Out of range value"},
+		{DetailKeyServerVersion, "8.0.36"},
+		{DetailKeyThreadID, "42"},
+	}
+	if len(details) != len(want) {
+		t.Fatalf("len(details) = %d, want %d (%+v)", len(details), len(want), details)
+	}
+	for i, w := range want {
+		if details[i].Key != w.key || string(details[i].Value) != w.value {
+			t.Errorf("details[%d] = {%s, %s}, want {%s, %s}", i, details[i].Key, details[i].Value, w.key, w.value)
+		}
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestInspectWarnings_NoWarnings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() = %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery("SHOW COUNT\\(\\*\\) WARNINGS").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("db.Conn() = %v", err)
+	}
+	defer conn.Close()
+
+	inspector := MySQLErrorInspector{}
+	details, err := inspector.InspectWarnings(context.Background(), conn)
+	if err != nil {
+		t.Fatalf("InspectWarnings() = %v", err)
+	}
+	if details != nil {
+		t.Errorf("details = %+v, want nil", details)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2025 ADBC Drivers Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command mysqlerrors regenerates mysql_error_table.go from errors.tsv.
+// It is invoked via `go generate` from error_inspector.go and should not
+// be built or run directly outside of that.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+type entry struct {
+	Code     int
+	SQLState string
+	Status   string
+}
+
+const tmplText = `// Code generated by go generate from internal/gen/mysqlerrors/errors.tsv; DO NOT EDIT.
+
+package mysql
+
+import "github.com/apache/arrow-adbc/go/adbc"
+
+// mysqlErrorEntry is the statically known SQLSTATE and adbc.Status for a
+// MySQL server (ER_*) or client (CR_*) error number.
+type mysqlErrorEntry struct {
+	SQLState string
+	Status   adbc.Status
+}
+
+// mysqlErrorTable maps MySQL error numbers to their SQLSTATE and adbc.Status
+// classification. It is generated from internal/gen/mysqlerrors/errors.tsv;
+// edit that file and run "go generate ./..." to refresh this table.
+var mysqlErrorTable = map[int]mysqlErrorEntry{
+{{- range . }}
+	{{ .Code }}: {SQLState: {{ printf "%q" .SQLState }}, Status: adbc.{{ .Status }}},
+{{- end }}
+}
+`
+
+func main() {
+	input := flag.String("input", "errors.tsv", "path to the TSV error table source")
+	output := flag.String("output", "", "path to write the generated Go file")
+	flag.Parse()
+
+	entries, err := readEntries(*input)
+	if err != nil {
+		log.Fatalf("mysqlerrors: %v", err)
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			log.Fatalf("mysqlerrors: %v", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	tmpl := template.Must(template.New("table").Parse(tmplText))
+	if err := tmpl.Execute(out, entries); err != nil {
+		log.Fatalf("mysqlerrors: %v", err)
+	}
+}
+
+func readEntries(path string) ([]entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var entries []entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// Strip a trailing "# comment" before splitting on tabs.
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%s: malformed row %q", path, line)
+		}
+		code, err := strconv.Atoi(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, fmt.Errorf("%s: bad error code %q: %w", path, fields[0], err)
+		}
+		entries = append(entries, entry{
+			Code:     code,
+			SQLState: strings.TrimSpace(fields[1]),
+			Status:   strings.TrimSpace(fields[2]),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Code < entries[j].Code })
+	return entries, nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2025 ADBC Drivers Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retry implements the mysql driver's reconnect and deadlock-retry
+// loop. NewPolicy builds a Policy from the adbc.mysql.retry.* database
+// options, and Do runs an operation under it, using a Classify function
+// (the mysql package adapts MySQLErrorInspector.Classify to it) to decide
+// whether to reconnect, back off and retry, or give up. No statement
+// execution path in the mysql package calls Do.
+package retry
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+// Option keys read from the database's option map by NewPolicy.
+const (
+	OptionMaxAttempts     = "adbc.mysql.retry.max_attempts"
+	OptionDeadlockBackoff = "adbc.mysql.retry.deadlock_backoff"
+	OptionReconnectOnIO   = "adbc.mysql.retry.reconnect_on_io"
+)
+
+// Policy controls how many times an operation is retried, the base backoff
+// for deadlock/lock-wait retries, and whether a dropped connection should
+// be transparently reconnected.
+type Policy struct {
+	// MaxAttempts is the total number of times an operation is run,
+	// including the first attempt. Must be at least 1.
+	MaxAttempts int
+	// DeadlockBackoff is the base sleep before retrying an operation that
+	// failed with a classification that didn't supply its own backoff.
+	// It is jittered by +/-50% on each attempt.
+	DeadlockBackoff time.Duration
+	// ReconnectOnIO enables transparently re-opening the connection and
+	// retrying when an operation fails with a Classification that asks for
+	// it (server-gone/lost-connection errors). When false, such errors are
+	// returned to the caller on the first occurrence.
+	ReconnectOnIO bool
+}
+
+// DefaultPolicy is used for any option NewPolicy isn't given a value for.
+var DefaultPolicy = Policy{
+	MaxAttempts:     3,
+	DeadlockBackoff: 25 * time.Millisecond,
+	ReconnectOnIO:   true,
+}
+
+// NewPolicy builds a Policy from the database option map, starting from
+// DefaultPolicy and overriding it with any of OptionMaxAttempts,
+// OptionDeadlockBackoff, or OptionReconnectOnIO present in options.
+func NewPolicy(options map[string]string) (Policy, error) {
+	policy := DefaultPolicy
+
+	if v, ok := options[OptionMaxAttempts]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return Policy{}, fmt.Errorf("retry: %s must be a positive integer, got %q", OptionMaxAttempts, v)
+		}
+		policy.MaxAttempts = n
+	}
+
+	if v, ok := options[OptionDeadlockBackoff]; ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return Policy{}, fmt.Errorf("retry: %s: %w", OptionDeadlockBackoff, err)
+		}
+		policy.DeadlockBackoff = d
+	}
+
+	if v, ok := options[OptionReconnectOnIO]; ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return Policy{}, fmt.Errorf("retry: %s must be a bool, got %q", OptionReconnectOnIO, v)
+		}
+		policy.ReconnectOnIO = b
+	}
+
+	return policy, nil
+}
+
+// Classification is the subset of MySQLErrorInspector.Classify's result the
+// retry loop needs. It is a separate type (rather than importing the mysql
+// package's Classification directly) so this package has no dependency on
+// the driver package it's retrying for.
+type Classification struct {
+	Retryable bool
+	Backoff   time.Duration
+	Reconnect bool
+}
+
+// Classify reports the Classification for a failed attempt's error.
+type Classify func(err error) Classification
+
+// Reconnect re-opens the underlying connection (and re-prepares any
+// statement) ahead of a retry that needs it.
+type Reconnect func(ctx context.Context) error
+
+// Do runs fn, retrying it under policy when classify reports its error as
+// retryable: a Classification with Reconnect set calls reconnect before the
+// next attempt, otherwise Do sleeps for a jittered backoff (its own, or
+// policy.DeadlockBackoff if it didn't supply one) and retries fn in place.
+// Do gives up and returns the most recent error once it is not retryable,
+// reconnect fails, the context is done, or policy.MaxAttempts is reached.
+func Do(ctx context.Context, policy Policy, classify Classify, reconnect Reconnect, fn func(ctx context.Context) error) error {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+
+	var err error
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		if err = fn(ctx); err == nil {
+			return nil
+		}
+		if attempt == policy.MaxAttempts {
+			return err
+		}
+
+		class := classify(err)
+		if !class.Retryable {
+			return err
+		}
+
+		if class.Reconnect {
+			if !policy.ReconnectOnIO {
+				return err
+			}
+			if rerr := reconnect(ctx); rerr != nil {
+				return rerr
+			}
+			continue
+		}
+
+		backoff := class.Backoff
+		if backoff <= 0 {
+			backoff = policy.DeadlockBackoff
+		}
+		if serr := sleep(ctx, jitter(backoff)); serr != nil {
+			return serr
+		}
+	}
+	return err
+}
+
+// jitter returns d scaled by a random factor in [0.5, 1.5), so concurrent
+// retries after the same deadlock don't all collide again in lockstep.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(float64(d) * (0.5 + rand.Float64()))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
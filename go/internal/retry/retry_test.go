@@ -0,0 +1,144 @@
+// Copyright (c) 2025 ADBC Drivers Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// classify mimics the driver's classificationOf adapter (mysql package's
+// MySQLErrorInspector.Classify) against synthetic *mysql.MySQLError values,
+// without importing the mysql package itself (that would be a cycle: the
+// mysql package imports retry).
+func classify(err error) Classification {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1213: // ER_LOCK_DEADLOCK
+			return Classification{Retryable: true, Backoff: 10 * time.Millisecond}
+		case 2006: // CR_SERVER_GONE_ERROR
+			return Classification{Retryable: true, Reconnect: true}
+		}
+		return Classification{}
+	}
+	return Classification{}
+}
+
+func TestDo_SucceedsFirstTry(t *testing.T) {
+	calls := 0
+	err := Do(context.Background(), DefaultPolicy, classify, neverReconnect(t), func(context.Context) error {
+		calls++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Do() = %v, want nil", err)
+	}
+	if calls != 1 {
+		t.Fatalf("fn called %d times, want 1", calls)
+	}
+}
+
+func TestDo_RetriesDeadlockThenSucceeds(t *testing.T) {
+	calls := 0
+	err := Do(context.Background(), DefaultPolicy, classify, neverReconnect(t), func(context.Context) error {
+		calls++
+		if calls < 2 {
+			return &mysql.MySQLError{Number: 1213, Message: "Deadlock found"}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Do() = %v, want nil", err)
+	}
+	if calls != 2 {
+		t.Fatalf("fn called %d times, want 2", calls)
+	}
+}
+
+func TestDo_ReconnectsOnServerGone(t *testing.T) {
+	calls, reconnects := 0, 0
+	reconnect := func(context.Context) error {
+		reconnects++
+		return nil
+	}
+	err := Do(context.Background(), DefaultPolicy, classify, reconnect, func(context.Context) error {
+		calls++
+		if calls < 2 {
+			return &mysql.MySQLError{Number: 2006, Message: "MySQL server has gone away"}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Do() = %v, want nil", err)
+	}
+	if reconnects != 1 {
+		t.Fatalf("reconnect called %d times, want 1", reconnects)
+	}
+}
+
+func TestDo_ReconnectFailureAborts(t *testing.T) {
+	wantErr := errors.New("reconnect failed")
+	reconnect := func(context.Context) error { return wantErr }
+	err := Do(context.Background(), DefaultPolicy, classify, reconnect, func(context.Context) error {
+		return &mysql.MySQLError{Number: 2006, Message: "MySQL server has gone away"}
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Do() = %v, want %v", err, wantErr)
+	}
+}
+
+func TestDo_GivesUpAfterMaxAttempts(t *testing.T) {
+	policy := Policy{MaxAttempts: 3, DeadlockBackoff: time.Millisecond}
+	calls := 0
+	deadlock := &mysql.MySQLError{Number: 1213, Message: "Deadlock found"}
+	err := Do(context.Background(), policy, classify, neverReconnect(t), func(context.Context) error {
+		calls++
+		return deadlock
+	})
+	if !errors.Is(err, error(deadlock)) && err.Error() != deadlock.Error() {
+		t.Fatalf("Do() = %v, want %v", err, deadlock)
+	}
+	if calls != policy.MaxAttempts {
+		t.Fatalf("fn called %d times, want %d", calls, policy.MaxAttempts)
+	}
+}
+
+func TestDo_NonRetryableReturnsImmediately(t *testing.T) {
+	calls := 0
+	syntaxErr := &mysql.MySQLError{Number: 1064, Message: "You have an error in your SQL syntax"}
+	err := Do(context.Background(), DefaultPolicy, classify, neverReconnect(t), func(context.Context) error {
+		calls++
+		return syntaxErr
+	})
+	if err != error(syntaxErr) {
+		t.Fatalf("Do() = %v, want %v", err, syntaxErr)
+	}
+	if calls != 1 {
+		t.Fatalf("fn called %d times, want 1", calls)
+	}
+}
+
+// neverReconnect returns a Reconnect that fails the test if it's ever called.
+func neverReconnect(t *testing.T) Reconnect {
+	return func(context.Context) error {
+		t.Fatal("reconnect called unexpectedly")
+		return nil
+	}
+}
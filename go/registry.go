@@ -0,0 +1,115 @@
+// Copyright (c) 2025 ADBC Drivers Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"sync"
+	"time"
+
+	"github.com/apache/arrow-adbc/go/adbc"
+	"github.com/go-sql-driver/mysql"
+)
+
+// Rule classifies a MySQL error into an adbc.Status and a retry decision.
+// Status is only applied when the rule matches the error passed to it;
+// retryable and backoff tell callers (via IsRetryable) whether it's safe to
+// retry the operation and, if so, how long to wait first.
+type Rule func(mysqlErr *mysql.MySQLError) (status adbc.Status, retryable bool, backoff time.Duration)
+
+// errorRegistry holds classification rules keyed by error code and by
+// SQLSTATE prefix; a matching rule takes priority over mysqlErrorTable in
+// InspectError. Code rules take priority over SQLSTATE-prefix rules, and longer
+// prefixes take priority over shorter ones.
+type errorRegistry struct {
+	mu         sync.RWMutex
+	byCode     map[int]Rule
+	bySQLState map[string]Rule
+}
+
+var defaultRegistry = &errorRegistry{
+	byCode:     make(map[int]Rule),
+	bySQLState: make(map[string]Rule),
+}
+
+// RegisterErrorRule registers rule to run for any MySQL error with the given
+// numeric code, taking priority over mysqlErrorTable and any SQLSTATE-prefix
+// rule. Drivers and middleware embedding this package (a ProxySQL-style
+// proxy, a gh-ost-style online-schema-migration tool, a Vitess/PlanetScale/
+// TiDB compatibility shim) can call this from an init function to add
+// site-specific classifications. Safe for concurrent use; a later call for
+// the same code replaces the earlier rule.
+func RegisterErrorRule(code int, rule Rule) {
+	defaultRegistry.mu.Lock()
+	defer defaultRegistry.mu.Unlock()
+	defaultRegistry.byCode[code] = rule
+}
+
+// RegisterSQLStateRule registers rule to run for any MySQL error whose
+// SQLSTATE starts with prefix, when no code-specific rule matched. Safe for
+// concurrent use; a later call for the same prefix replaces the earlier
+// rule.
+func RegisterSQLStateRule(prefix string, rule Rule) {
+	defaultRegistry.mu.Lock()
+	defer defaultRegistry.mu.Unlock()
+	defaultRegistry.bySQLState[prefix] = rule
+}
+
+// lookup returns the most specific registered rule for mysqlErr, if any.
+// sqlState is matched by prefix for RegisterSQLStateRule and should be the
+// caller's already-backfilled SQLSTATE (see effectiveSQLState), not
+// mysqlErr.SQLState directly, since that is all zero when the error carries
+// no SQLSTATE.
+func (r *errorRegistry) lookup(mysqlErr *mysql.MySQLError, sqlState string) (Rule, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if rule, ok := r.byCode[int(mysqlErr.Number)]; ok {
+		return rule, true
+	}
+
+	for n := len(sqlState); n > 0; n-- {
+		if rule, ok := r.bySQLState[sqlState[:n]]; ok {
+			return rule, true
+		}
+	}
+	return nil, false
+}
+
+func init() {
+	// Deadlocks are always safe to retry: MySQL guarantees the whole
+	// transaction was rolled back, so no partial effects survive. This does
+	// NOT extend to 1205 (ER_LOCK_WAIT_TIMEOUT): under the server default
+	// innodb_rollback_on_timeout=OFF, a lock-wait timeout only rolls back the
+	// statement that timed out, not the rest of the transaction, so blindly
+	// retrying it risks re-running a statement against a transaction that
+	// still has earlier uncommitted side effects. Register a rule for 1205
+	// explicitly if your deployment sets innodb_rollback_on_timeout=ON.
+	RegisterErrorRule(1213, func(*mysql.MySQLError) (adbc.Status, bool, time.Duration) { // ER_LOCK_DEADLOCK
+		return adbc.StatusCancelled, true, 50 * time.Millisecond
+	})
+	RegisterErrorRule(1317, func(*mysql.MySQLError) (adbc.Status, bool, time.Duration) { // ER_QUERY_INTERRUPTED
+		return adbc.StatusCancelled, true, 0
+	})
+
+	// Transient connection drops: the server (or network) closed the
+	// connection out from under us, so reconnecting and retrying is safe as
+	// long as the statement hadn't already taken effect.
+	for _, code := range []int{2002, 2003, 2006, 2013} {
+		code := code
+		RegisterErrorRule(code, func(*mysql.MySQLError) (adbc.Status, bool, time.Duration) {
+			return adbc.StatusIO, true, 0
+		})
+	}
+}